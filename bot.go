@@ -0,0 +1,225 @@
+package telebot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// Endpoints for the core update kinds. Use these with Bot.Handle to
+// register handlers, e.g. bot.Handle(telebot.OnMessage, ...).
+const (
+	// OnMessage is the endpoint for a plain incoming message, of any
+	// content kind (text, photo, sticker, location, etc).
+	OnMessage = "\amessage"
+
+	OnCallback = "\acallback"
+	OnQuery    = "\aquery"
+)
+
+// Bot represents a Telegram bot instance.
+type Bot struct {
+	Token string
+
+	transport Transport
+	codec     Codec
+
+	handlers map[string]interface{}
+}
+
+// Settings configures a new Bot instance.
+type Settings struct {
+	Token string
+
+	// Transport performs outgoing Bot API calls. Defaults to a
+	// net/http-backed implementation if left nil; see
+	// github.com/aero89/telebot/transport/fasthttp for an alternative
+	// tuned for high request volume.
+	Transport Transport
+
+	// Codec encodes and decodes the JSON bodies exchanged with the Bot
+	// API, including incoming webhook Update payloads. Defaults to
+	// encoding/json if left nil; see
+	// github.com/aero89/telebot/codec/jsoniter for a faster
+	// alternative under high request volume.
+	Codec Codec
+}
+
+// NewBot returns a Bot configured per pref, falling back to the
+// default net/http Transport and encoding/json Codec for any left nil.
+func NewBot(pref Settings) *Bot {
+	transport := pref.Transport
+	if transport == nil {
+		transport = newDefaultTransport("https://api.telegram.org/bot"+pref.Token, nil)
+	}
+
+	codec := pref.Codec
+	if codec == nil {
+		codec = stdCodec{}
+	}
+
+	return &Bot{
+		Token:     pref.Token,
+		transport: transport,
+		codec:     codec,
+		handlers:  make(map[string]interface{}),
+	}
+}
+
+// Handle registers a handler for the given endpoint, e.g.
+// bot.Handle(telebot.OnMessage, func(m *Message) { ... }).
+func (b *Bot) Handle(endpoint string, handler interface{}) {
+	b.handlers[endpoint] = handler
+}
+
+// handle invokes the handler registered for end, if any, passing args
+// through as its parameters. It reports false if no handler is
+// registered, or its signature doesn't accept args.
+func (b *Bot) handle(end string, args ...interface{}) bool {
+	handler, ok := b.handlers[end]
+	if !ok {
+		return false
+	}
+
+	fn := reflect.ValueOf(handler)
+	if fn.Kind() != reflect.Func || fn.Type().NumIn() != len(args) {
+		return false
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, a := range args {
+		v := reflect.ValueOf(a)
+		if !v.IsValid() || !v.Type().AssignableTo(fn.Type().In(i)) {
+			return false
+		}
+		in[i] = v
+	}
+
+	fn.Call(in)
+	return true
+}
+
+// apiResponse mirrors the envelope every Bot API response is wrapped in.
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+// checkResult decodes the Bot API envelope and turns an "ok": false
+// response into a Go error, returning the raw body otherwise.
+func (b *Bot) checkResult(data []byte) ([]byte, error) {
+	var resp apiResponse
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, errors.New(resp.Description)
+	}
+	return data, nil
+}
+
+// sendObject issues a Bot API call with URL-encoded params and
+// returns the raw response body.
+func (b *Bot) sendObject(params map[string]string, method string) ([]byte, error) {
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		values.Set(k, v)
+	}
+
+	data, err := b.transport.Do(method, values, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.checkResult(data)
+}
+
+// sendFile issues a multipart Bot API call, attaching file under the
+// given form field alongside params, and returns the raw response body.
+func (b *Bot) sendFile(params map[string]string, method, field string, file *File) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for k, v := range params {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile(field, file.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := os.Open(file.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	if _, err := io.Copy(part, src); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	data, err := b.transport.Do(method, nil, &MultipartBody{Reader: &buf, ContentType: writer.FormDataContentType()})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.checkResult(data)
+}
+
+// extractOptions merges opts into params, dispatching ReplyMarkup to
+// whichever concrete markup it holds and marshaling it via b.codec.
+func (b *Bot) extractOptions(opts *SendOptions, params map[string]string) error {
+	if opts == nil {
+		return nil
+	}
+
+	if opts.ReplyTo != nil {
+		params["reply_to_message_id"] = strconv.Itoa(opts.ReplyTo.ID)
+	}
+
+	if opts.ReplyMarkup != nil {
+		data, err := b.codec.Marshal(opts.ReplyMarkup)
+		if err != nil {
+			return err
+		}
+		params["reply_markup"] = string(data)
+	}
+
+	if opts.DisableWebPagePreview {
+		params["disable_web_page_preview"] = "true"
+	}
+	if opts.DisableNotification {
+		params["disable_notification"] = "true"
+	}
+	if opts.ParseMode != "" {
+		params["parse_mode"] = opts.ParseMode
+	}
+
+	return nil
+}
+
+// extractMessage unwraps the "result" field of a Bot API response
+// into a Message.
+func (b *Bot) extractMessage(data []byte) (*Message, error) {
+	var resp struct {
+		Result *Message
+	}
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,93 @@
+package telebot
+
+import (
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type fakeTransport struct {
+	calls       int
+	contentType string
+	lastParams  url.Values
+	lastBody    string
+	response    string
+}
+
+func (f *fakeTransport) Do(method string, params url.Values, body io.Reader) ([]byte, error) {
+	f.calls++
+	f.lastParams = params
+	if mb, ok := body.(*MultipartBody); ok {
+		f.contentType = mb.ContentType
+	}
+	if body != nil {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		f.lastBody = string(data)
+	}
+	return []byte(f.response), nil
+}
+
+type fakeCodec struct {
+	unmarshalCalls int
+}
+
+func (c *fakeCodec) Marshal(v interface{}) ([]byte, error) {
+	return stdCodec{}.Marshal(v)
+}
+
+func (c *fakeCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshalCalls++
+	return stdCodec{}.Unmarshal(data, v)
+}
+
+func TestNewBotDefaultsToStdTransportAndCodec(t *testing.T) {
+	b := NewBot(Settings{Token: "t"})
+
+	if b.transport == nil {
+		t.Fatal("expected a default Transport")
+	}
+	if _, ok := b.codec.(stdCodec); !ok {
+		t.Fatalf("codec = %T, want stdCodec", b.codec)
+	}
+}
+
+func TestNewBotUsesProvidedTransportAndCodec(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"message_id":1}}`}
+	fc := &fakeCodec{}
+
+	b := NewBot(Settings{Token: "t", Transport: ft, Codec: fc})
+
+	if _, err := b.sendObject(map[string]string{"a": "b"}, "sendMessage"); err != nil {
+		t.Fatalf("sendObject() error = %v", err)
+	}
+
+	if ft.calls != 1 {
+		t.Fatalf("transport calls = %d, want 1", ft.calls)
+	}
+	if fc.unmarshalCalls == 0 {
+		t.Fatal("expected the configured codec to decode the response, it wasn't used")
+	}
+}
+
+func TestSendFileSetsMultipartContentType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sticker.png")
+	if err := os.WriteFile(path, []byte("fake png bytes"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	ft := &fakeTransport{response: `{"ok":true,"result":{}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	if _, err := b.sendFile(nil, "uploadStickerFile", "png_sticker", &File{FilePath: path}); err != nil {
+		t.Fatalf("sendFile() error = %v", err)
+	}
+
+	if ft.contentType == "" {
+		t.Fatal("expected sendFile to set a multipart Content-Type, got none")
+	}
+}
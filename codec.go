@@ -0,0 +1,24 @@
+package telebot
+
+import "encoding/json"
+
+// Codec abstracts the JSON encoding used to talk to the Bot API,
+// including decoding webhook Update payloads. The default is backed by
+// encoding/json; callers receiving updates at a high rate can swap in
+// a faster implementation (e.g. jsoniter) to cut down on decoding
+// overhead — see github.com/aero89/telebot/codec/jsoniter.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec is the default Codec, backed by encoding/json.
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
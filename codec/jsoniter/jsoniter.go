@@ -0,0 +1,26 @@
+// Package jsoniter provides a telebot.Codec backed by
+// json-iterator/go, a drop-in faster replacement for encoding/json
+// recommended for bots receiving updates at a high rate.
+package jsoniter
+
+import jsoniter "github.com/json-iterator/go"
+
+// Codec is a telebot.Codec backed by jsoniter's ConfigCompatibleWithStandardLibrary.
+type Codec struct {
+	api jsoniter.API
+}
+
+// New returns a ready-to-use jsoniter-backed Codec.
+func New() *Codec {
+	return &Codec{api: jsoniter.ConfigCompatibleWithStandardLibrary}
+}
+
+// Marshal implements telebot.Codec.
+func (c *Codec) Marshal(v interface{}) ([]byte, error) {
+	return c.api.Marshal(v)
+}
+
+// Unmarshal implements telebot.Codec.
+func (c *Codec) Unmarshal(data []byte, v interface{}) error {
+	return c.api.Unmarshal(data, v)
+}
@@ -0,0 +1,42 @@
+package jsoniter
+
+import "testing"
+
+type update struct {
+	ID      int64  `json:"update_id"`
+	Message string `json:"message"`
+}
+
+func TestCodecRoundTripsUpdate(t *testing.T) {
+	c := New()
+
+	data, err := c.Marshal(update{ID: 42, Message: "hi"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got update
+	if err := c.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got.ID != 42 || got.Message != "hi" {
+		t.Fatalf("got %+v, want {ID:42 Message:hi}", got)
+	}
+}
+
+func TestCodecUnmarshalsAPIResponseEnvelope(t *testing.T) {
+	c := New()
+
+	var resp struct {
+		OK     bool
+		Result update
+	}
+	if err := c.Unmarshal([]byte(`{"ok":true,"result":{"update_id":7,"message":"ok"}}`), &resp); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if !resp.OK || resp.Result.ID != 7 || resp.Result.Message != "ok" {
+		t.Fatalf("got %+v, want OK result {ID:7 Message:ok}", resp)
+	}
+}
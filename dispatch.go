@@ -0,0 +1,63 @@
+package telebot
+
+// Endpoints for the update kinds added alongside Payload, Callback and
+// Query. Use these with Bot.Handle to register handlers, e.g.
+// bot.Handle(telebot.OnEditedMessage, ...).
+const (
+	OnEditedMessage      = "\aedited_message"
+	OnChannelPost        = "\achannel_post"
+	OnEditedChannelPost  = "\aedited_channel_post"
+	OnChosenInlineResult = "\achosen_inline_result"
+	OnShippingQuery      = "\ashipping_query"
+	OnPreCheckoutQuery   = "\apre_checkout_query"
+)
+
+// ProcessUpdate dispatches a single incoming update to the handler
+// registered for its kind. WebhookHandler funnels every update it
+// receives through this method, so registering with Bot.Handle is the
+// only thing callers need to do to react to updates.
+func (b *Bot) ProcessUpdate(upd Update) {
+	if upd.Payload != nil {
+		b.handle(OnMessage, upd.Payload)
+		return
+	}
+
+	if upd.EditedMessage != nil {
+		b.handle(OnEditedMessage, upd.EditedMessage)
+		return
+	}
+
+	if upd.ChannelPost != nil {
+		b.handle(OnChannelPost, upd.ChannelPost)
+		return
+	}
+
+	if upd.EditedChannelPost != nil {
+		b.handle(OnEditedChannelPost, upd.EditedChannelPost)
+		return
+	}
+
+	if upd.Callback != nil {
+		b.handle(OnCallback, upd.Callback)
+		return
+	}
+
+	if upd.Query != nil {
+		b.handle(OnQuery, upd.Query)
+		return
+	}
+
+	if upd.ChosenInlineResult != nil {
+		b.handle(OnChosenInlineResult, upd.ChosenInlineResult)
+		return
+	}
+
+	if upd.ShippingQuery != nil {
+		b.handle(OnShippingQuery, upd.ShippingQuery)
+		return
+	}
+
+	if upd.PreCheckoutQuery != nil {
+		b.handle(OnPreCheckoutQuery, upd.PreCheckoutQuery)
+	}
+}
@@ -0,0 +1,122 @@
+package telebot
+
+import "testing"
+
+func TestProcessUpdateRoutesGenericMessageToOnMessage(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Message
+	b.Handle(OnMessage, func(m *Message) { got = m })
+
+	sticker := &Sticker{}
+	b.ProcessUpdate(Update{Payload: &Message{Sticker: sticker}})
+
+	if got == nil || got.Sticker != sticker {
+		t.Fatalf("expected the sticker message to reach OnMessage, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesEditedMessage(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Message
+	b.Handle(OnEditedMessage, func(m *Message) { got = m })
+
+	edited := &Message{Text: "edited"}
+	b.ProcessUpdate(Update{EditedMessage: edited})
+
+	if got != edited {
+		t.Fatalf("expected EditedMessage to reach OnEditedMessage, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesChannelPost(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Message
+	b.Handle(OnChannelPost, func(m *Message) { got = m })
+
+	post := &Message{Text: "announcement"}
+	b.ProcessUpdate(Update{ChannelPost: post})
+
+	if got != post {
+		t.Fatalf("expected ChannelPost to reach OnChannelPost, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesEditedChannelPost(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Message
+	b.Handle(OnEditedChannelPost, func(m *Message) { got = m })
+
+	edited := &Message{Text: "edited announcement"}
+	b.ProcessUpdate(Update{EditedChannelPost: edited})
+
+	if got != edited {
+		t.Fatalf("expected EditedChannelPost to reach OnEditedChannelPost, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesQuery(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Query
+	b.Handle(OnQuery, func(q *Query) { got = q })
+
+	query := &Query{ID: "q1"}
+	b.ProcessUpdate(Update{Query: query})
+
+	if got != query {
+		t.Fatalf("expected Query to reach OnQuery, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesChosenInlineResult(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *ChosenInlineResult
+	b.Handle(OnChosenInlineResult, func(r *ChosenInlineResult) { got = r })
+
+	result := &ChosenInlineResult{ResultID: "r1"}
+	b.ProcessUpdate(Update{ChosenInlineResult: result})
+
+	if got != result {
+		t.Fatalf("expected ChosenInlineResult to reach OnChosenInlineResult, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesShippingQuery(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *ShippingQuery
+	b.Handle(OnShippingQuery, func(q *ShippingQuery) { got = q })
+
+	query := &ShippingQuery{ID: "sq1"}
+	b.ProcessUpdate(Update{ShippingQuery: query})
+
+	if got != query {
+		t.Fatalf("expected ShippingQuery to reach OnShippingQuery, got %v", got)
+	}
+}
+
+func TestProcessUpdateRoutesPreCheckoutQuery(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *PreCheckoutQuery
+	b.Handle(OnPreCheckoutQuery, func(q *PreCheckoutQuery) { got = q })
+
+	query := &PreCheckoutQuery{ID: "pq1"}
+	b.ProcessUpdate(Update{PreCheckoutQuery: query})
+
+	if got != query {
+		t.Fatalf("expected PreCheckoutQuery to reach OnPreCheckoutQuery, got %v", got)
+	}
+}
+
+func TestProcessUpdateIgnoresUnregisteredEndpoint(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	// No handler registered for OnMessage; ProcessUpdate must not panic.
+	b.ProcessUpdate(Update{Payload: &Message{Text: "hi"}})
+}
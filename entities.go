@@ -0,0 +1,98 @@
+package telebot
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Entity type constants, as used in MessageEntity.Type.
+const (
+	EntityMention     = "mention"
+	EntityHashtag     = "hashtag"
+	EntityCommand     = "bot_command"
+	EntityURL         = "url"
+	EntityEmail       = "email"
+	EntityBold        = "bold"
+	EntityItalic      = "italic"
+	EntityCode        = "code"
+	EntityCodeBlock   = "pre"
+	EntityTextLink    = "text_link"
+	EntityTextMention = "text_mention"
+)
+
+// MessageEntity object represents a special entity in a text message,
+// e.g. a hashtag, a username or a bot command. Entities are reported
+// as offsets in UTF-16 code units into the message text, matching the
+// Bot API.
+type MessageEntity struct {
+	// Type of the entity, see telebot.Entity* constants.
+	Type string `json:"type"`
+
+	// Offset in UTF-16 code units to the start of the entity.
+	Offset int `json:"offset"`
+
+	// Length of the entity in UTF-16 code units.
+	Length int `json:"length"`
+
+	// URL is set for "text_link" entities only, the URL that will be
+	// opened after the user taps on the text.
+	URL string `json:"url,omitempty"`
+
+	// User is set for "text_mention" entities only, the mentioned user.
+	User *User `json:"user,omitempty"`
+
+	// Language is set for "pre" (code block) entities only, the
+	// programming language of the code block.
+	Language string `json:"language,omitempty"`
+}
+
+// IsCommand reports whether the message starts with a "/command".
+func (m *Message) IsCommand() bool {
+	if len(m.Entities) == 0 || m.Entities[0].Offset != 0 {
+		return false
+	}
+	return m.Entities[0].Type == EntityCommand
+}
+
+// EntityText extracts the substring of the message (or caption) that
+// the given entity refers to. Offset and Length are in UTF-16 code
+// units, matching the Bot API, so the text is re-encoded to UTF-16
+// before indexing rather than sliced as runes.
+func (m *Message) EntityText(e MessageEntity) string {
+	text := m.Text
+	if text == "" {
+		text = m.Caption
+	}
+
+	units := utf16.Encode([]rune(text))
+	if e.Offset < 0 || e.Offset+e.Length > len(units) {
+		return ""
+	}
+
+	return string(utf16.Decode(units[e.Offset : e.Offset+e.Length]))
+}
+
+// CommandArguments returns the text following the /command, with the
+// command itself and the leading space stripped. Returns "" if the
+// message isn't a command or carries no arguments.
+func (m *Message) CommandArguments() string {
+	if !m.IsCommand() {
+		return ""
+	}
+
+	cmd := m.EntityText(m.Entities[0])
+	return strings.TrimSpace(strings.TrimPrefix(m.Text, cmd))
+}
+
+// MentionedUsers returns the users mentioned in the message via
+// "text_mention" entities, i.e. mentions of users without a username
+// that Telegram resolves to a User object directly.
+func (m *Message) MentionedUsers() []User {
+	var users []User
+	for _, e := range m.Entities {
+		if e.Type == EntityTextMention && e.User != nil {
+			users = append(users, *e.User)
+		}
+	}
+	return users
+}
@@ -0,0 +1,68 @@
+package telebot
+
+import "testing"
+
+func TestEntityTextUsesUTF16Offsets(t *testing.T) {
+	// "👍 hello" - the thumbs-up emoji is outside the BMP and takes two
+	// UTF-16 code units, so "hello" starts at offset 3, not rune-index 2.
+	m := &Message{
+		Text: "\U0001F44D hello",
+		Entities: []MessageEntity{
+			{Type: EntityBold, Offset: 3, Length: 5},
+		},
+	}
+
+	got := m.EntityText(m.Entities[0])
+	if got != "hello" {
+		t.Fatalf("EntityText() = %q, want %q", got, "hello")
+	}
+}
+
+func TestIsCommandAndCommandArguments(t *testing.T) {
+	m := &Message{
+		Text: "/start foo bar",
+		Entities: []MessageEntity{
+			{Type: EntityCommand, Offset: 0, Length: 6},
+		},
+	}
+
+	if !m.IsCommand() {
+		t.Fatal("IsCommand() = false, want true")
+	}
+
+	if got, want := m.CommandArguments(), "foo bar"; got != want {
+		t.Fatalf("CommandArguments() = %q, want %q", got, want)
+	}
+}
+
+func TestIsCommandFalseWithoutLeadingCommandEntity(t *testing.T) {
+	m := &Message{
+		Text: "hello /start",
+		Entities: []MessageEntity{
+			{Type: EntityCommand, Offset: 6, Length: 6},
+		},
+	}
+
+	if m.IsCommand() {
+		t.Fatal("IsCommand() = true, want false")
+	}
+	if got := m.CommandArguments(); got != "" {
+		t.Fatalf("CommandArguments() = %q, want empty", got)
+	}
+}
+
+func TestMentionedUsers(t *testing.T) {
+	alice := &User{ID: 1, FirstName: "Alice"}
+	m := &Message{
+		Text: "hi Alice",
+		Entities: []MessageEntity{
+			{Type: EntityTextMention, Offset: 3, Length: 5, User: alice},
+			{Type: EntityBold, Offset: 0, Length: 2},
+		},
+	}
+
+	users := m.MentionedUsers()
+	if len(users) != 1 || users[0].ID != alice.ID {
+		t.Fatalf("MentionedUsers() = %+v, want [%+v]", users, *alice)
+	}
+}
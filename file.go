@@ -0,0 +1,13 @@
+package telebot
+
+// File object represents any sort of file uploaded to or downloaded
+// from Telegram.
+type File struct {
+	FileID   string `json:"file_id"`
+	FileSize int64  `json:"file_size,omitempty"`
+
+	// FilePath is either the path Telegram reports for a previously
+	// uploaded file, or a local filesystem path when the File is being
+	// sent from disk.
+	FilePath string `json:"file_path,omitempty"`
+}
@@ -0,0 +1,62 @@
+package telebot
+
+// ReplyMarkup is any of the keyboard markups that can be attached to
+// an outgoing message via SendOptions.ReplyMarkup: InlineKeyboardMarkup,
+// ReplyKeyboardMarkup, ReplyKeyboardRemove or ForceReply.
+type ReplyMarkup interface {
+	// replyMarkup is unexported so only markups declared in this
+	// package satisfy the interface.
+	replyMarkup()
+}
+
+func (InlineKeyboardMarkup) replyMarkup() {}
+func (ReplyKeyboardMarkup) replyMarkup()  {}
+func (ReplyKeyboardRemove) replyMarkup()  {}
+func (ForceReply) replyMarkup()           {}
+
+// ReplyKeyboardMarkup represents a custom reply keyboard with buttons
+// that replace the user's regular keyboard.
+type ReplyKeyboardMarkup struct {
+	// Array of button rows, each represented by an Array of
+	// KeyboardButton objects.
+	ReplyKeyboard [][]KeyboardButton `json:"keyboard"`
+
+	// ResizeKeyboard requests clients to resize the keyboard
+	// vertically for optimal fit, making it appear smaller if there
+	// are just a few buttons. Defaults to false.
+	ResizeKeyboard bool `json:"resize_keyboard,omitempty"`
+
+	// OneTimeKeyboard requests clients to hide the keyboard as soon
+	// as it's been used. Defaults to false.
+	OneTimeKeyboard bool `json:"one_time_keyboard,omitempty"`
+
+	// Selective, if true, shows the keyboard only to specific users:
+	// those mentioned in the Text of the Message object, or the
+	// original sender if the message is a reply.
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ReplyKeyboardRemove requests clients to remove the custom keyboard,
+// reverting to the default letter-keyboard.
+type ReplyKeyboardRemove struct {
+	// RemoveKeyboard must be true; present only so the JSON body
+	// matches the Bot API shape.
+	RemoveKeyboard bool `json:"remove_keyboard"`
+
+	// Selective, if true, removes the keyboard only for specific
+	// users, as in ReplyKeyboardMarkup.Selective.
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ForceReply forces clients to show a reply interface to the user,
+// as if they'd explicitly selected the bot's message and tapped
+// "Reply". Useful for quiz flows and private replies.
+type ForceReply struct {
+	// ForceReply must be true; present only so the JSON body matches
+	// the Bot API shape.
+	ForceReply bool `json:"force_reply"`
+
+	// Selective, if true, forces the reply interface only for
+	// specific users, as in ReplyKeyboardMarkup.Selective.
+	Selective bool `json:"selective,omitempty"`
+}
@@ -0,0 +1,41 @@
+package telebot
+
+// Message object represents a message.
+type Message struct {
+	ID   int   `json:"message_id"`
+	Date int64 `json:"date"`
+
+	// Sender is empty for messages sent to channels.
+	Sender *User `json:"from"`
+	Chat   *Chat `json:"chat"`
+
+	// ReplyTo is set if the message is itself a reply to another message.
+	ReplyTo *Message `json:"reply_to_message,omitempty"`
+
+	Text     string          `json:"text,omitempty"`
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	Photo    *Photo    `json:"photo,omitempty"`
+	Audio    *Audio    `json:"audio,omitempty"`
+	Voice    *Voice    `json:"voice,omitempty"`
+	Document *Document `json:"document,omitempty"`
+	Sticker  *Sticker  `json:"sticker,omitempty"`
+	Video    *Video    `json:"video,omitempty"`
+
+	Contact  *Contact  `json:"contact,omitempty"`
+	Location *Location `json:"location,omitempty"`
+	Venue    *Venue    `json:"venue,omitempty"`
+
+	// SuccessfulPayment is set on the service message Telegram sends
+	// once an Invoice has been paid.
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment,omitempty"`
+}
+
+// Destination is internal chat ID, so a Message can itself be used as
+// a Recipient when replying.
+func (m *Message) Destination() string {
+	return m.Chat.Destination()
+}
@@ -0,0 +1,203 @@
+package telebot
+
+// LabeledPrice represents a portion of the final price for an Invoice,
+// e.g. a product cost, tax, discount, delivery cost, delivery tax, bonus, etc.
+type LabeledPrice struct {
+	Label string `json:"label"`
+
+	// Amount is the price in the smallest units of the currency
+	// (integer, not float/double). For example, for a price of US$ 1.45
+	// pass amount = 145.
+	Amount int `json:"amount"`
+}
+
+// Invoice contains basic information about an invoice.
+type Invoice struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+
+	// Payload is internal bot-defined invoice payload, not visible
+	// to the user.
+	Payload string `json:"payload"`
+
+	// ProviderToken is a payment provider token, obtained via Botfather.
+	ProviderToken string `json:"provider_token"`
+
+	// StartParameter is the unique deep-linking parameter used to
+	// generate a https://t.me/your_bot?start=XXXX link for this
+	// invoice. Leave empty to omit the link.
+	StartParameter string `json:"start_parameter,omitempty"`
+
+	// Currency is a three-letter ISO 4217 currency code.
+	Currency string `json:"currency"`
+
+	Prices []LabeledPrice `json:"prices"`
+
+	// (Optional) NeedName, NeedPhoneNumber, NeedEmail, NeedShippingAddress
+	// request the respective information from the user before checkout.
+	NeedName            bool `json:"need_name,omitempty"`
+	NeedPhoneNumber     bool `json:"need_phone_number,omitempty"`
+	NeedEmail           bool `json:"need_email,omitempty"`
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+
+	// (Optional) IsFlexible must be true if the final price depends on
+	// the shipping method chosen by the user.
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// ShippingAddress represents a shipping address.
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// OrderInfo represents information about an order.
+type OrderInfo struct {
+	Name            string           `json:"name,omitempty"`
+	PhoneNumber     string           `json:"phone_number,omitempty"`
+	Email           string           `json:"email,omitempty"`
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
+// ShippingOption represents one shipping option offered to the user in
+// response to a ShippingQuery.
+type ShippingOption struct {
+	ID     string         `json:"id"`
+	Title  string         `json:"title"`
+	Prices []LabeledPrice `json:"prices"`
+}
+
+// ShippingQuery contains information about an incoming shipping query,
+// sent when a user has specified a shipping address for an Invoice
+// with IsFlexible set.
+type ShippingQuery struct {
+	ID      string           `json:"id"`
+	Sender  *User            `json:"from"`
+	Payload string           `json:"invoice_payload"`
+	Address *ShippingAddress `json:"shipping_address"`
+}
+
+// PreCheckoutQuery contains information about an incoming pre-checkout
+// query, sent right before the user confirms payment.
+type PreCheckoutQuery struct {
+	ID               string     `json:"id"`
+	Sender           *User      `json:"from"`
+	Currency         string     `json:"currency"`
+	Total            int        `json:"total_amount"`
+	Payload          string     `json:"invoice_payload"`
+	ShippingOptionID string     `json:"shipping_option_id,omitempty"`
+	OrderInfo        *OrderInfo `json:"order_info,omitempty"`
+}
+
+// SuccessfulPayment contains basic information about a successful
+// payment, reported on the Message that completes it.
+type SuccessfulPayment struct {
+	Currency                string     `json:"currency"`
+	Total                   int        `json:"total_amount"`
+	Payload                 string     `json:"invoice_payload"`
+	ShippingOptionID        string     `json:"shipping_option_id,omitempty"`
+	OrderInfo               *OrderInfo `json:"order_info,omitempty"`
+	TelegramPaymentChargeID string     `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID string     `json:"provider_payment_charge_id"`
+}
+
+// SendInvoice sends an Invoice to the given recipient. opts may carry
+// a ReplyMarkup, e.g. an InlineKeyboardMarkup whose first button has
+// Pay set, to attach a "Pay" button to the message.
+//
+// See also: https://core.telegram.org/bots/api#sendinvoice
+func (b *Bot) SendInvoice(to Recipient, invoice *Invoice, opts *SendOptions) (*Message, error) {
+	prices, err := b.codec.Marshal(invoice.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"chat_id":         to.Destination(),
+		"title":           invoice.Title,
+		"description":     invoice.Description,
+		"payload":         invoice.Payload,
+		"provider_token":  invoice.ProviderToken,
+		"start_parameter": invoice.StartParameter,
+		"currency":        invoice.Currency,
+		"prices":          string(prices),
+	}
+
+	if invoice.NeedName {
+		params["need_name"] = "true"
+	}
+	if invoice.NeedPhoneNumber {
+		params["need_phone_number"] = "true"
+	}
+	if invoice.NeedEmail {
+		params["need_email"] = "true"
+	}
+	if invoice.NeedShippingAddress {
+		params["need_shipping_address"] = "true"
+	}
+	if invoice.IsFlexible {
+		params["is_flexible"] = "true"
+	}
+
+	if err := b.extractOptions(opts, params); err != nil {
+		return nil, err
+	}
+
+	data, err := b.sendObject(params, "sendInvoice")
+	if err != nil {
+		return nil, err
+	}
+
+	return b.extractMessage(data)
+}
+
+// AnswerShippingQuery replies to a ShippingQuery, either with a list of
+// available shipping options or with an error explaining why checkout
+// can't proceed.
+//
+// See also: https://core.telegram.org/bots/api#answershippingquery
+func (b *Bot) AnswerShippingQuery(query *ShippingQuery, options []ShippingOption, errorMessage string) error {
+	params := map[string]string{
+		"shipping_query_id": query.ID,
+	}
+
+	if errorMessage != "" {
+		params["ok"] = "false"
+		params["error_message"] = errorMessage
+	} else {
+		params["ok"] = "true"
+
+		data, err := b.codec.Marshal(options)
+		if err != nil {
+			return err
+		}
+		params["shipping_options"] = string(data)
+	}
+
+	_, err := b.sendObject(params, "answerShippingQuery")
+	return err
+}
+
+// AnswerPreCheckoutQuery replies to a PreCheckoutQuery, confirming or
+// rejecting the checkout right before the payment is charged.
+//
+// See also: https://core.telegram.org/bots/api#answerprecheckoutquery
+func (b *Bot) AnswerPreCheckoutQuery(query *PreCheckoutQuery, errorMessage string) error {
+	params := map[string]string{
+		"pre_checkout_query_id": query.ID,
+	}
+
+	if errorMessage != "" {
+		params["ok"] = "false"
+		params["error_message"] = errorMessage
+	} else {
+		params["ok"] = "true"
+	}
+
+	_, err := b.sendObject(params, "answerPreCheckoutQuery")
+	return err
+}
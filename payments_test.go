@@ -0,0 +1,138 @@
+package telebot
+
+import "testing"
+
+func TestSendInvoiceMarshalsPricesAndOptionalFields(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"message_id":1}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	invoice := &Invoice{
+		Title:      "Widget",
+		Currency:   "USD",
+		Prices:     []LabeledPrice{{Label: "Widget", Amount: 100}},
+		IsFlexible: true,
+	}
+
+	if _, err := b.SendInvoice(&Chat{ID: 42}, invoice, nil); err != nil {
+		t.Fatalf("SendInvoice() error = %v", err)
+	}
+
+	want := `[{"label":"Widget","amount":100}]`
+	if got := ft.lastParams.Get("prices"); got != want {
+		t.Fatalf("prices = %q, want %q", got, want)
+	}
+	if got := ft.lastParams.Get("is_flexible"); got != "true" {
+		t.Fatalf("is_flexible = %q, want %q", got, "true")
+	}
+	if _, ok := ft.lastParams["need_name"]; ok {
+		t.Fatal("need_name should be omitted when false")
+	}
+}
+
+func TestSendInvoiceMarshalsStartParameterAndReplyMarkup(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"message_id":1}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	invoice := &Invoice{
+		Title:          "Widget",
+		Currency:       "USD",
+		Prices:         []LabeledPrice{{Label: "Widget", Amount: 100}},
+		StartParameter: "widget-promo",
+	}
+	opts := &SendOptions{
+		ReplyMarkup: InlineKeyboardMarkup{InlineKeyboard: [][]InlineButton{{{Text: "Pay", Pay: true}}}},
+	}
+
+	if _, err := b.SendInvoice(&Chat{ID: 42}, invoice, opts); err != nil {
+		t.Fatalf("SendInvoice() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("start_parameter"); got != "widget-promo" {
+		t.Fatalf("start_parameter = %q, want %q", got, "widget-promo")
+	}
+
+	want := `{"inline_keyboard":[[{"text":"Pay","pay":true}]]}`
+	if got := ft.lastParams.Get("reply_markup"); got != want {
+		t.Fatalf("reply_markup = %q, want %q", got, want)
+	}
+}
+
+func TestAnswerShippingQueryMarshalsOptionsWhenOK(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	query := &ShippingQuery{ID: "q1"}
+	options := []ShippingOption{{ID: "opt1", Title: "Standard", Prices: []LabeledPrice{{Label: "Shipping", Amount: 500}}}}
+
+	if err := b.AnswerShippingQuery(query, options, ""); err != nil {
+		t.Fatalf("AnswerShippingQuery() error = %v", err)
+	}
+
+	want := `[{"id":"opt1","title":"Standard","prices":[{"label":"Shipping","amount":500}]}]`
+	if got := ft.lastParams.Get("shipping_options"); got != want {
+		t.Fatalf("shipping_options = %q, want %q", got, want)
+	}
+	if got := ft.lastParams.Get("ok"); got != "true" {
+		t.Fatalf("ok = %q, want %q", got, "true")
+	}
+}
+
+func TestAnswerPreCheckoutQueryMarshalsOK(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	query := &PreCheckoutQuery{ID: "pq1"}
+
+	if err := b.AnswerPreCheckoutQuery(query, ""); err != nil {
+		t.Fatalf("AnswerPreCheckoutQuery() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("pre_checkout_query_id"); got != "pq1" {
+		t.Fatalf("pre_checkout_query_id = %q, want %q", got, "pq1")
+	}
+	if got := ft.lastParams.Get("ok"); got != "true" {
+		t.Fatalf("ok = %q, want %q", got, "true")
+	}
+	if _, ok := ft.lastParams["error_message"]; ok {
+		t.Fatal("error_message should be omitted on success")
+	}
+}
+
+func TestAnswerPreCheckoutQueryWithErrorSetsMessage(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	query := &PreCheckoutQuery{ID: "pq1"}
+
+	if err := b.AnswerPreCheckoutQuery(query, "out of stock"); err != nil {
+		t.Fatalf("AnswerPreCheckoutQuery() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("ok"); got != "false" {
+		t.Fatalf("ok = %q, want %q", got, "false")
+	}
+	if got := ft.lastParams.Get("error_message"); got != "out of stock" {
+		t.Fatalf("error_message = %q, want %q", got, "out of stock")
+	}
+}
+
+func TestAnswerShippingQueryWithErrorSkipsOptions(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	query := &ShippingQuery{ID: "q1"}
+
+	if err := b.AnswerShippingQuery(query, nil, "address not served"); err != nil {
+		t.Fatalf("AnswerShippingQuery() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("ok"); got != "false" {
+		t.Fatalf("ok = %q, want %q", got, "false")
+	}
+	if _, ok := ft.lastParams["shipping_options"]; ok {
+		t.Fatal("shipping_options should be omitted on error")
+	}
+	if got := ft.lastParams.Get("error_message"); got != "address not served" {
+		t.Fatalf("error_message = %q, want %q", got, "address not served")
+	}
+}
@@ -0,0 +1,64 @@
+package telebot
+
+import "strconv"
+
+// PollTimeout is the long-poll timeout, in seconds, sent as the
+// "timeout" param on every getUpdates call.
+const PollTimeout = 60
+
+// Start begins long polling for updates via getUpdates and dispatches
+// each one through ProcessUpdate, exactly as WebhookHandler does for
+// pushed updates. It blocks until stop is closed, returning nil, or
+// until a getUpdates call fails, returning that error.
+//
+// Start is the alternative to SetWebhook: run it when the bot has no
+// public HTTPS endpoint for Telegram to push updates to.
+//
+// See also: https://core.telegram.org/bots/api#getupdates
+func (b *Bot) Start(stop <-chan struct{}) error {
+	var offset int64
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+
+		updates, err := b.getUpdates(offset)
+		if err != nil {
+			return err
+		}
+
+		for _, upd := range updates {
+			offset = upd.ID + 1
+			b.ProcessUpdate(upd)
+		}
+	}
+}
+
+// getUpdates issues a single getUpdates call starting at offset,
+// blocking on the Bot API side for up to PollTimeout seconds if no
+// updates are immediately available.
+func (b *Bot) getUpdates(offset int64) ([]Update, error) {
+	params := map[string]string{
+		"timeout": strconv.Itoa(PollTimeout),
+	}
+	if offset != 0 {
+		params["offset"] = strconv.FormatInt(offset, 10)
+	}
+
+	data, err := b.sendObject(params, "getUpdates")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result []Update
+	}
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
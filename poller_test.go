@@ -0,0 +1,57 @@
+package telebot
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"testing"
+)
+
+type scriptedTransport struct {
+	responses  []string
+	calls      int
+	lastParams url.Values
+}
+
+func (s *scriptedTransport) Do(method string, params url.Values, body io.Reader) ([]byte, error) {
+	s.lastParams = params
+	if s.calls >= len(s.responses) {
+		return nil, errors.New("scriptedTransport: exhausted")
+	}
+	resp := s.responses[s.calls]
+	s.calls++
+	return []byte(resp), nil
+}
+
+func TestStartDispatchesUpdatesAndAdvancesOffset(t *testing.T) {
+	st := &scriptedTransport{responses: []string{
+		`{"ok":true,"result":[{"update_id":5,"message":{"text":"hi"}}]}`,
+	}}
+	b := NewBot(Settings{Token: "t", Transport: st})
+
+	var got *Message
+	b.Handle(OnMessage, func(m *Message) { got = m })
+
+	if err := b.Start(make(chan struct{})); err == nil {
+		t.Fatal("expected Start to return the transport error once responses run out")
+	}
+
+	if got == nil || got.Text != "hi" {
+		t.Fatalf("expected the polled message to reach OnMessage, got %v", got)
+	}
+
+	if want := "6"; st.lastParams.Get("offset") != want {
+		t.Fatalf("offset = %q, want %q after update_id 5", st.lastParams.Get("offset"), want)
+	}
+}
+
+func TestStartStopsWhenStopClosed(t *testing.T) {
+	b := NewBot(Settings{Token: "t", Transport: &scriptedTransport{}})
+
+	stop := make(chan struct{})
+	close(stop)
+
+	if err := b.Start(stop); err != nil {
+		t.Fatalf("Start() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,14 @@
+package telebot
+
+// Query object represents an incoming inline query, sent when a user
+// types “@your_bot query” in a chat.
+type Query struct {
+	ID     string `json:"id"`
+	Sender *User  `json:"from"`
+
+	// Location is set only for bots that request user location.
+	Location *Location `json:"location,omitempty"`
+
+	Text   string `json:"query"`
+	Offset string `json:"offset"`
+}
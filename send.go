@@ -0,0 +1,46 @@
+package telebot
+
+// SendMessage sends a text message to the given recipient, applying
+// opts if non-nil (reply target, keyboard markup, parse mode, etc).
+//
+// See also: https://core.telegram.org/bots/api#sendmessage
+func (b *Bot) SendMessage(to Recipient, text string, opts *SendOptions) (*Message, error) {
+	params := map[string]string{
+		"chat_id": to.Destination(),
+		"text":    text,
+	}
+
+	if err := b.extractOptions(opts, params); err != nil {
+		return nil, err
+	}
+
+	data, err := b.sendObject(params, "sendMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	return b.extractMessage(data)
+}
+
+// SendOptions represents a set of custom options that could be
+// applied to a message on sending, as used by Sendable.Send.
+type SendOptions struct {
+	// ReplyTo, if set, makes the message a reply to that one.
+	ReplyTo *Message
+
+	// ReplyMarkup attaches a keyboard to the message: an
+	// InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove
+	// or ForceReply.
+	ReplyMarkup ReplyMarkup
+
+	// DisableWebPagePreview, if true, disables link previews for
+	// links in the message text.
+	DisableWebPagePreview bool
+
+	// DisableNotification, if true, sends the message silently.
+	DisableNotification bool
+
+	// ParseMode controls how the message text is parsed: "" (plain
+	// text), "Markdown" or "HTML".
+	ParseMode string
+}
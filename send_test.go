@@ -0,0 +1,121 @@
+package telebot
+
+import "testing"
+
+func TestExtractOptionsMarshalsEachReplyMarkupKind(t *testing.T) {
+	b := NewBot(Settings{Token: "t"})
+
+	tests := []struct {
+		name   string
+		markup ReplyMarkup
+		want   string
+	}{
+		{
+			name:   "inline keyboard",
+			markup: InlineKeyboardMarkup{InlineKeyboard: [][]InlineButton{{{Text: "Go", URL: "https://example.com"}}}},
+			want:   `{"inline_keyboard":[[{"text":"Go","url":"https://example.com"}]]}`,
+		},
+		{
+			name:   "reply keyboard",
+			markup: ReplyKeyboardMarkup{ReplyKeyboard: [][]KeyboardButton{{{Text: "Yes"}}}, OneTimeKeyboard: true},
+			want:   `{"keyboard":[[{"text":"Yes"}]],"one_time_keyboard":true}`,
+		},
+		{
+			name:   "reply keyboard remove",
+			markup: ReplyKeyboardRemove{RemoveKeyboard: true},
+			want:   `{"remove_keyboard":true}`,
+		},
+		{
+			name:   "force reply",
+			markup: ForceReply{ForceReply: true, Selective: true},
+			want:   `{"force_reply":true,"selective":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]string{}
+			if err := b.extractOptions(&SendOptions{ReplyMarkup: tt.markup}, params); err != nil {
+				t.Fatalf("extractOptions() error = %v", err)
+			}
+			if got := params["reply_markup"]; got != tt.want {
+				t.Fatalf("reply_markup = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendMessageSendsTextAndReplyMarkup(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"message_id":1,"text":"hi"}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	opts := &SendOptions{
+		ReplyMarkup: ReplyKeyboardRemove{RemoveKeyboard: true},
+	}
+
+	if _, err := b.SendMessage(&Chat{ID: 42}, "hi", opts); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("chat_id"); got != "42" {
+		t.Fatalf("chat_id = %q, want %q", got, "42")
+	}
+	if got := ft.lastParams.Get("text"); got != "hi" {
+		t.Fatalf("text = %q, want %q", got, "hi")
+	}
+	if got := ft.lastParams.Get("reply_markup"); got != `{"remove_keyboard":true}` {
+		t.Fatalf("reply_markup = %q, want %q", got, `{"remove_keyboard":true}`)
+	}
+}
+
+func TestSendMessageWithoutOptionsOmitsReplyMarkup(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"message_id":1}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	if _, err := b.SendMessage(&Chat{ID: 42}, "hi", nil); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+
+	if _, ok := ft.lastParams["reply_markup"]; ok {
+		t.Fatal("reply_markup should be omitted when opts is nil")
+	}
+}
+
+func TestExtractOptionsNilIsNoop(t *testing.T) {
+	b := NewBot(Settings{Token: "t"})
+
+	params := map[string]string{}
+	if err := b.extractOptions(nil, params); err != nil {
+		t.Fatalf("extractOptions() error = %v", err)
+	}
+	if len(params) != 0 {
+		t.Fatalf("params = %v, want empty", params)
+	}
+}
+
+func TestExtractOptionsSetsReplyAndFormatting(t *testing.T) {
+	b := NewBot(Settings{Token: "t"})
+
+	params := map[string]string{}
+	opts := &SendOptions{
+		ReplyTo:               &Message{ID: 7},
+		DisableWebPagePreview: true,
+		DisableNotification:   true,
+		ParseMode:             "HTML",
+	}
+	if err := b.extractOptions(opts, params); err != nil {
+		t.Fatalf("extractOptions() error = %v", err)
+	}
+
+	want := map[string]string{
+		"reply_to_message_id":      "7",
+		"disable_web_page_preview": "true",
+		"disable_notification":     "true",
+		"parse_mode":               "HTML",
+	}
+	for k, v := range want {
+		if params[k] != v {
+			t.Fatalf("params[%q] = %q, want %q", k, params[k], v)
+		}
+	}
+}
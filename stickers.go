@@ -0,0 +1,143 @@
+package telebot
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GetStickerSet returns a sticker set by its name.
+//
+// See also: https://core.telegram.org/bots/api#getstickerset
+func (b *Bot) GetStickerSet(name string) (*StickerSet, error) {
+	params := map[string]string{"name": name}
+
+	data, err := b.sendObject(params, "getStickerSet")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *StickerSet
+	}
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// UploadStickerFile uploads a .png sticker file for later use in
+// CreateNewStickerSet or AddStickerToSet. The returned File.FileID can be
+// reused without re-uploading the same image.
+//
+// See also: https://core.telegram.org/bots/api#uploadstickerfile
+func (b *Bot) UploadStickerFile(owner *User, png *File) (*File, error) {
+	params := map[string]string{
+		"user_id": owner.Destination(),
+	}
+
+	data, err := b.sendFile(params, "uploadStickerFile", "png_sticker", png)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *File
+	}
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user. The
+// set becomes available to the bot via GetStickerSet and can be
+// extended with AddStickerToSet.
+//
+// See also: https://core.telegram.org/bots/api#createnewstickerset
+func (b *Bot) CreateNewStickerSet(owner *User, name, title string, sticker *File, emoji string, mask *MaskPosition) error {
+	params := map[string]string{
+		"user_id": owner.Destination(),
+		"name":    name,
+		"title":   title,
+		"emojis":  emoji,
+	}
+
+	field := "png_sticker"
+	if isAnimatedSticker(sticker) {
+		field = "tgs_sticker"
+	}
+
+	if mask != nil {
+		data, err := b.codec.Marshal(mask)
+		if err != nil {
+			return err
+		}
+		params["mask_position"] = string(data)
+	}
+
+	_, err := b.sendFile(params, "createNewStickerSet", field, sticker)
+	return err
+}
+
+// AddStickerToSet appends a sticker to an existing set previously
+// created with CreateNewStickerSet.
+//
+// See also: https://core.telegram.org/bots/api#addstickertoset
+func (b *Bot) AddStickerToSet(owner *User, name string, sticker *File, emoji string, mask *MaskPosition) error {
+	params := map[string]string{
+		"user_id": owner.Destination(),
+		"name":    name,
+		"emojis":  emoji,
+	}
+
+	field := "png_sticker"
+	if isAnimatedSticker(sticker) {
+		field = "tgs_sticker"
+	}
+
+	if mask != nil {
+		data, err := b.codec.Marshal(mask)
+		if err != nil {
+			return err
+		}
+		params["mask_position"] = string(data)
+	}
+
+	_, err := b.sendFile(params, "addStickerToSet", field, sticker)
+	return err
+}
+
+// SetStickerPositionInSet moves a sticker to a new position (0-based)
+// within its set.
+//
+// See also: https://core.telegram.org/bots/api#setstickerpositioninset
+func (b *Bot) SetStickerPositionInSet(sticker *Sticker, position int) error {
+	params := map[string]string{
+		"sticker":  sticker.FileID,
+		"position": strconv.Itoa(position),
+	}
+
+	_, err := b.sendObject(params, "setStickerPositionInSet")
+	return err
+}
+
+// DeleteStickerFromSet removes a sticker from its set.
+//
+// See also: https://core.telegram.org/bots/api#deletestickerfromset
+func (b *Bot) DeleteStickerFromSet(sticker *Sticker) error {
+	params := map[string]string{
+		"sticker": sticker.FileID,
+	}
+
+	_, err := b.sendObject(params, "deleteStickerFromSet")
+	return err
+}
+
+// isAnimatedSticker reports whether the given file looks like an
+// animated (.tgs) sticker rather than a static .png/.webp one, so the
+// multipart uploader can pick the right form field.
+func isAnimatedSticker(f *File) bool {
+	return strings.HasSuffix(strings.ToLower(f.FilePath), ".tgs")
+}
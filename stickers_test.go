@@ -0,0 +1,157 @@
+package telebot
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetStickerSetDecodesResult(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"name":"pack","title":"Pack"}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	set, err := b.GetStickerSet("pack")
+	if err != nil {
+		t.Fatalf("GetStickerSet() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("name"); got != "pack" {
+		t.Fatalf("name = %q, want %q", got, "pack")
+	}
+	if set == nil || set.Name != "pack" {
+		t.Fatalf("set = %v, want Name = %q", set, "pack")
+	}
+}
+
+func TestUploadStickerFileSendsPNGField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sticker.png")
+	if err := os.WriteFile(path, []byte("fake png bytes"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	ft := &fakeTransport{response: `{"ok":true,"result":{"file_id":"f1"}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	file, err := b.UploadStickerFile(&User{ID: 1}, &File{FilePath: path})
+	if err != nil {
+		t.Fatalf("UploadStickerFile() error = %v", err)
+	}
+
+	if ft.contentType == "" {
+		t.Fatal("expected a multipart Content-Type")
+	}
+	if !strings.Contains(ft.lastBody, `name="png_sticker"`) {
+		t.Fatal("expected the file under the png_sticker form field")
+	}
+	if file == nil || file.FileID != "f1" {
+		t.Fatalf("file = %v, want FileID = %q", file, "f1")
+	}
+}
+
+func TestCreateNewStickerSetPicksFieldByFileKind(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		field string
+	}{
+		{"static png", "sticker.png", "png_sticker"},
+		{"animated tgs", "sticker.tgs", "tgs_sticker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.path)
+			if err := os.WriteFile(path, []byte("fake sticker bytes"), 0o600); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+
+			ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+			b := NewBot(Settings{Token: "t", Transport: ft})
+
+			err := b.CreateNewStickerSet(&User{ID: 1}, "pack", "Pack", &File{FilePath: path}, "ud83d", nil)
+			if err != nil {
+				t.Fatalf("CreateNewStickerSet() error = %v", err)
+			}
+
+			if ft.contentType == "" {
+				t.Fatal("expected a multipart Content-Type")
+			}
+			if !strings.Contains(ft.lastBody, `name="`+tt.field+`"`) {
+				t.Fatalf("expected the file under the %s form field", tt.field)
+			}
+		})
+	}
+}
+
+func TestAddStickerToSetMarshalsMaskPosition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sticker.png")
+	if err := os.WriteFile(path, []byte("fake png bytes"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	mask := &MaskPosition{Point: "forehead", Scale: 1.5}
+	err := b.AddStickerToSet(&User{ID: 1}, "pack", &File{FilePath: path}, "ud83d", mask)
+	if err != nil {
+		t.Fatalf("AddStickerToSet() error = %v", err)
+	}
+
+	if ft.contentType == "" {
+		t.Fatal("expected a multipart Content-Type")
+	}
+	want := `{"point":"forehead","x_shift":0,"y_shift":0,"scale":1.5}`
+	if !strings.Contains(ft.lastBody, `name="mask_position"`) || !strings.Contains(ft.lastBody, want) {
+		t.Fatalf("expected a mask_position field holding %s", want)
+	}
+}
+
+func TestSetStickerPositionInSetSendsPosition(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	if err := b.SetStickerPositionInSet(&Sticker{File: File{FileID: "s1"}}, 2); err != nil {
+		t.Fatalf("SetStickerPositionInSet() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("sticker"); got != "s1" {
+		t.Fatalf("sticker = %q, want %q", got, "s1")
+	}
+	if got := ft.lastParams.Get("position"); got != "2" {
+		t.Fatalf("position = %q, want %q", got, "2")
+	}
+}
+
+func TestDeleteStickerFromSetSendsStickerID(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	if err := b.DeleteStickerFromSet(&Sticker{File: File{FileID: "s1"}}); err != nil {
+		t.Fatalf("DeleteStickerFromSet() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("sticker"); got != "s1" {
+		t.Fatalf("sticker = %q, want %q", got, "s1")
+	}
+}
+
+func TestIsAnimatedSticker(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"sticker.png", false},
+		{"sticker.webp", false},
+		{"sticker.tgs", true},
+		{"STICKER.TGS", true},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isAnimatedSticker(&File{FilePath: tt.path}); got != tt.want {
+			t.Errorf("isAnimatedSticker(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package telebot
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Transport performs a single Bot API HTTP call and returns the raw
+// response body. Bot uses it for every outgoing request, so it's the
+// seam for swapping in an alternative HTTP stack — see
+// github.com/aero89/telebot/transport/fasthttp for a fasthttp-backed
+// implementation tuned for high request volume.
+type Transport interface {
+	Do(method string, params url.Values, body io.Reader) ([]byte, error)
+}
+
+// MultipartBody pairs a multipart-encoded body with the Content-Type
+// header (including boundary) it must be sent with, so a Transport
+// implementation — including third-party ones, like
+// github.com/aero89/telebot/transport/fasthttp — can set that header
+// without needing to know anything about multipart encoding itself.
+type MultipartBody struct {
+	io.Reader
+	ContentType string
+}
+
+// defaultTransport is the Transport used when Bot is constructed
+// without one explicitly set. It's a thin wrapper around net/http.
+type defaultTransport struct {
+	base   string
+	client *http.Client
+}
+
+// newDefaultTransport builds the net/http-backed Transport rooted at
+// the given Bot API base URL (including the bot token).
+func newDefaultTransport(base string, client *http.Client) *defaultTransport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &defaultTransport{base: base, client: client}
+}
+
+func (t *defaultTransport) Do(method string, params url.Values, body io.Reader) ([]byte, error) {
+	url := t.base + "/" + method
+	if body == nil && params != nil {
+		url += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if mb, ok := body.(*MultipartBody); ok {
+		req.Header.Set("Content-Type", mb.ContentType)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,66 @@
+// Package fasthttp provides a telebot.Transport backed by
+// valyala/fasthttp, for bots that need to squeeze out the TLS
+// handshake and allocation overhead of net/http under heavy request
+// volume.
+package fasthttp
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/aero89/telebot"
+)
+
+// Transport is a telebot.Transport backed by a fasthttp.Client.
+type Transport struct {
+	Base   string
+	Client *fasthttp.Client
+}
+
+// New builds a Transport rooted at the given Bot API base URL
+// (including the bot token). A nil client falls back to a
+// fasthttp.Client with its zero-value defaults.
+func New(base string, client *fasthttp.Client) *Transport {
+	if client == nil {
+		client = &fasthttp.Client{}
+	}
+	return &Transport{Base: base, Client: client}
+}
+
+// Do implements telebot.Transport.
+func (t *Transport) Do(method string, params url.Values, body io.Reader) ([]byte, error) {
+	uri := t.Base + "/" + method
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.Header.SetMethod("POST")
+
+	if body != nil {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBody(data)
+
+		if mb, ok := body.(*telebot.MultipartBody); ok {
+			req.Header.SetContentType(mb.ContentType)
+		}
+	} else if params != nil {
+		uri += "?" + params.Encode()
+	}
+
+	req.SetRequestURI(uri)
+
+	if err := t.Client.Do(req, resp); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(resp.Body()))
+	copy(out, resp.Body())
+	return out, nil
+}
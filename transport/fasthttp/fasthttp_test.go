@@ -0,0 +1,62 @@
+package fasthttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aero89/telebot"
+)
+
+func TestTransportDoBuildsQueryString(t *testing.T) {
+	var gotURL, gotMethod string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		gotMethod = r.Method
+		w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer srv.Close()
+
+	tr := New(srv.URL, nil)
+
+	data, err := tr.Do("getMe", url.Values{"chat_id": {"1"}}, nil)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Fatalf("method = %q, want POST", gotMethod)
+	}
+	if !strings.HasPrefix(gotURL, "/getMe?") || !strings.Contains(gotURL, "chat_id=1") {
+		t.Fatalf("url = %q, want /getMe with chat_id=1 in the query", gotURL)
+	}
+	if string(data) != `{"ok":true,"result":{}}` {
+		t.Fatalf("data = %q, want the server's raw body", data)
+	}
+}
+
+func TestTransportDoSetsMultipartContentType(t *testing.T) {
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer srv.Close()
+
+	tr := New(srv.URL, nil)
+
+	body := &telebot.MultipartBody{
+		Reader:      strings.NewReader("fake multipart body"),
+		ContentType: "multipart/form-data; boundary=xyz",
+	}
+
+	if _, err := tr.Do("uploadStickerFile", nil, body); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if gotContentType != "multipart/form-data; boundary=xyz" {
+		t.Fatalf("Content-Type = %q, want the MultipartBody's", gotContentType)
+	}
+}
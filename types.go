@@ -30,6 +30,17 @@ func (u *User) Destination() string {
 	return strconv.Itoa(u.ID)
 }
 
+// ChatType represents one of the possible Chat.Type values.
+type ChatType string
+
+// Chat types, as used in Chat.Type.
+const (
+	ChatPrivate    ChatType = "private"
+	ChatGroup      ChatType = "group"
+	ChatSuperGroup ChatType = "supergroup"
+	ChatChannel    ChatType = "channel"
+)
+
 // Chat object represents a Telegram user, bot or group chat.
 //
 // Type of chat, can be either “private”, “group”, "supergroup" or “channel”
@@ -67,8 +78,29 @@ type Update struct {
 	Payload *Message `json:"message"`
 
 	// optional
-	Callback *Callback `json:"callback_query"`
-	Query    *Query    `json:"inline_query"`
+	EditedMessage      *Message            `json:"edited_message"`
+	ChannelPost        *Message            `json:"channel_post"`
+	EditedChannelPost  *Message            `json:"edited_channel_post"`
+	Callback           *Callback           `json:"callback_query"`
+	Query              *Query              `json:"inline_query"`
+	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result"`
+	ShippingQuery      *ShippingQuery      `json:"shipping_query"`
+	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query"`
+}
+
+// ChosenInlineResult represents an inline result chosen by a user and
+// sent to their chat partner.
+type ChosenInlineResult struct {
+	ResultID string    `json:"result_id"`
+	Sender   *User     `json:"from"`
+	Location *Location `json:"location,omitempty"`
+
+	// InlineMessageID will be set if the message was sent via the
+	// bot in inline mode.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	// Query is the query that was used to obtain the result.
+	Query string `json:"query"`
 }
 
 // Photo object represents a photo (with or without caption).
@@ -78,7 +110,8 @@ type Photo struct {
 	Width  int `json:"width"`
 	Height int `json:"height"`
 
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // Audio object represents an audio file.
@@ -97,7 +130,8 @@ type Audio struct {
 	// MIME type (optional) of the file as defined by sender.
 	Mime string `json:"mime_type"`
 
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // Voice object represents a voice note.
@@ -110,7 +144,8 @@ type Voice struct {
 	// MIME type (optional) of the file as defined by sender.
 	Mime string `json:"mime_type"`
 
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // Document object represents a general file (as opposed to Photo or Audio).
@@ -127,7 +162,8 @@ type Document struct {
 	// MIME type of the file as defined by sender.
 	Mime string `json:"mime_type"`
 
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // Sticker object represents a WebP image, so-called sticker.
@@ -142,6 +178,44 @@ type Sticker struct {
 
 	// Associated emoji
 	Emoji string `json:"emoji"`
+
+	// SetName is the name of the sticker set the sticker belongs to,
+	// if any.
+	SetName string `json:"set_name,omitempty"`
+
+	// Animated is true if the sticker is animated (.tgs).
+	Animated bool `json:"is_animated,omitempty"`
+
+	// MaskPosition is set for mask stickers.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// StickerSet represents a sticker set.
+type StickerSet struct {
+	Name          string    `json:"name"`
+	Title         string    `json:"title"`
+	Animated      bool      `json:"is_animated"`
+	ContainsMasks bool      `json:"contains_masks"`
+	Stickers      []Sticker `json:"stickers"`
+}
+
+// MaskPosition describes the position on faces where a mask sticker
+// should be placed by default.
+type MaskPosition struct {
+	// Point is the part of the face relative to which the mask should
+	// be placed: "forehead", "eyes", "mouth" or "chin".
+	Point string `json:"point"`
+
+	// XShift and YShift are the shifts by X- and Y-axis measured in
+	// widths of the mask scaled to the face size, from the left and
+	// the top edge, respectively. A negative value moves the mask to
+	// the left/up.
+	XShift float32 `json:"x_shift"`
+	YShift float32 `json:"y_shift"`
+
+	// Scale is the mask scaling coefficient, e.g. 2.0 means a double
+	// size mask.
+	Scale float32 `json:"scale"`
 }
 
 // Video object represents an MP4-encoded video.
@@ -152,7 +226,8 @@ type Video struct {
 	Height int `json:"height"`
 
 	// Text description of the video as defined by sender.
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Video thumbnail.
 	Thumbnail Photo `json:"thumb"`
@@ -208,6 +283,25 @@ type InlineButton struct {
 	URL         string `json:"url,omitempty"`
 	Data        string `json:"callback_data,omitempty"`
 	InlineQuery string `json:"switch_inline_query,omitempty"`
+
+	// LoginURL, if set, turns the button into a Telegram Login button.
+	LoginURL *LoginURL `json:"login_url,omitempty"`
+
+	// Pay, if true, turns the button into a pay button. Must be the
+	// first button in the first row, and only in invoice messages.
+	Pay bool `json:"pay,omitempty"`
+}
+
+// LoginURL represents a parameter of an InlineButton used to
+// automatically authorize a user, serving as a replacement for the
+// Telegram Login Widget.
+//
+// See also: https://core.telegram.org/bots/api#loginurl
+type LoginURL struct {
+	URL                string `json:"url"`
+	ForwardText        string `json:"forward_text,omitempty"`
+	BotUsername        string `json:"bot_username,omitempty"`
+	RequestWriteAccess bool   `json:"request_write_access,omitempty"`
 }
 
 // InlineKeyboardMarkup represents an inline keyboard that appears
@@ -0,0 +1,146 @@
+package telebot
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// SecretTokenHeader is the header Telegram sets on every webhook
+// request when WebhookConfig.SecretToken is configured, so the
+// receiver can verify the request actually came from Telegram.
+const SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// WebhookConfig configures an outgoing setWebhook call.
+type WebhookConfig struct {
+	// URL is the HTTPS address Telegram should push updates to.
+	URL string
+
+	// Certificate is an optional self-signed public key certificate,
+	// uploaded so Telegram can pin it instead of relying on a CA.
+	Certificate *File
+
+	// MaxConnections caps the number of simultaneous HTTPS connections
+	// Telegram will open to the webhook (1-100, default 40).
+	MaxConnections int
+
+	// AllowedUpdates restricts the update kinds delivered to the
+	// webhook, e.g. {"message", "callback_query"}. An empty slice
+	// means all update kinds except ChatMember, matching the default
+	// Bot API behavior.
+	AllowedUpdates []string
+
+	// SecretToken, if set, is sent back by Telegram on every request
+	// in the X-Telegram-Bot-Api-Secret-Token header, letting
+	// WebhookHandler reject requests that don't carry it.
+	SecretToken string
+}
+
+// WebhookInfo describes the currently configured webhook, as returned
+// by GetWebhookInfo.
+type WebhookInfo struct {
+	URL                  string   `json:"url"`
+	HasCustomCertificate bool     `json:"has_custom_certificate"`
+	PendingUpdateCount   int      `json:"pending_update_count"`
+	MaxConnections       int      `json:"max_connections,omitempty"`
+	AllowedUpdates       []string `json:"allowed_updates,omitempty"`
+	LastErrorDate        int64    `json:"last_error_date,omitempty"`
+	LastErrorMessage     string   `json:"last_error_message,omitempty"`
+}
+
+// SetWebhook tells Telegram to push updates to cfg.URL instead of
+// relying on long polling via Bot.Start.
+//
+// See also: https://core.telegram.org/bots/api#setwebhook
+func (b *Bot) SetWebhook(cfg *WebhookConfig) error {
+	params := map[string]string{
+		"url": cfg.URL,
+	}
+
+	if cfg.MaxConnections != 0 {
+		params["max_connections"] = strconv.Itoa(cfg.MaxConnections)
+	}
+
+	if len(cfg.AllowedUpdates) != 0 {
+		data, err := b.codec.Marshal(cfg.AllowedUpdates)
+		if err != nil {
+			return err
+		}
+		params["allowed_updates"] = string(data)
+	}
+
+	if cfg.SecretToken != "" {
+		params["secret_token"] = cfg.SecretToken
+	}
+
+	if cfg.Certificate != nil {
+		_, err := b.sendFile(params, "setWebhook", "certificate", cfg.Certificate)
+		return err
+	}
+
+	_, err := b.sendObject(params, "setWebhook")
+	return err
+}
+
+// DeleteWebhook removes the configured webhook, switching the bot
+// back to long polling via Bot.Start.
+//
+// See also: https://core.telegram.org/bots/api#deletewebhook
+func (b *Bot) DeleteWebhook() error {
+	_, err := b.sendObject(nil, "deleteWebhook")
+	return err
+}
+
+// GetWebhookInfo returns information about the currently configured
+// webhook, including any delivery errors Telegram last ran into.
+//
+// See also: https://core.telegram.org/bots/api#getwebhookinfo
+func (b *Bot) GetWebhookInfo() (*WebhookInfo, error) {
+	data, err := b.sendObject(nil, "getWebhookInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result *WebhookInfo
+	}
+	if err := b.codec.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// WebhookHandler is an http.Handler that decodes incoming Update
+// bodies and feeds them through the same dispatcher ProcessUpdate
+// uses, so handlers registered with Bot.Handle work identically
+// whether updates arrive over the webhook or are fed in directly.
+type WebhookHandler struct {
+	Bot *Bot
+
+	// SecretToken, if set, must match the X-Telegram-Bot-Api-Secret-Token
+	// header on every request; mismatches are rejected with 401.
+	SecretToken string
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.SecretToken != "" && r.Header.Get(SecretTokenHeader) != h.SecretToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var upd Update
+	if err := h.Bot.codec.Unmarshal(body, &upd); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.Bot.ProcessUpdate(upd)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,134 @@
+package telebot
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetWebhookMarshalsParams(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	cfg := &WebhookConfig{
+		URL:            "https://example.com/hook",
+		MaxConnections: 10,
+		AllowedUpdates: []string{"message", "callback_query"},
+		SecretToken:    "shh",
+	}
+
+	if err := b.SetWebhook(cfg); err != nil {
+		t.Fatalf("SetWebhook() error = %v", err)
+	}
+
+	if got := ft.lastParams.Get("url"); got != "https://example.com/hook" {
+		t.Fatalf("url = %q, want %q", got, "https://example.com/hook")
+	}
+	if got := ft.lastParams.Get("max_connections"); got != "10" {
+		t.Fatalf("max_connections = %q, want %q", got, "10")
+	}
+	want := `["message","callback_query"]`
+	if got := ft.lastParams.Get("allowed_updates"); got != want {
+		t.Fatalf("allowed_updates = %q, want %q", got, want)
+	}
+	if got := ft.lastParams.Get("secret_token"); got != "shh" {
+		t.Fatalf("secret_token = %q, want %q", got, "shh")
+	}
+}
+
+func TestSetWebhookWithCertificateSendsMultipart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(path, []byte("fake cert bytes"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	cfg := &WebhookConfig{URL: "https://example.com/hook", Certificate: &File{FilePath: path}}
+
+	if err := b.SetWebhook(cfg); err != nil {
+		t.Fatalf("SetWebhook() error = %v", err)
+	}
+
+	if ft.contentType == "" {
+		t.Fatal("expected SetWebhook to send the certificate as multipart, got no Content-Type")
+	}
+}
+
+func TestDeleteWebhookSendsRequest(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":true}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	if err := b.DeleteWebhook(); err != nil {
+		t.Fatalf("DeleteWebhook() error = %v", err)
+	}
+	if ft.calls != 1 {
+		t.Fatalf("transport calls = %d, want 1", ft.calls)
+	}
+}
+
+func TestGetWebhookInfoDecodesResult(t *testing.T) {
+	ft := &fakeTransport{response: `{"ok":true,"result":{"url":"https://example.com/hook","pending_update_count":3}}`}
+	b := NewBot(Settings{Token: "t", Transport: ft})
+
+	info, err := b.GetWebhookInfo()
+	if err != nil {
+		t.Fatalf("GetWebhookInfo() error = %v", err)
+	}
+
+	if info == nil || info.URL != "https://example.com/hook" || info.PendingUpdateCount != 3 {
+		t.Fatalf("info = %+v, want URL = %q, PendingUpdateCount = 3", info, "https://example.com/hook")
+	}
+}
+
+func TestWebhookHandlerRejectsMismatchedSecretToken(t *testing.T) {
+	h := &WebhookHandler{Bot: NewBot(Settings{Token: "token"}), SecretToken: "expected"}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"update_id":1}`))
+	req.Header.Set(SecretTokenHeader, "wrong")
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != 401 {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+}
+
+func TestWebhookHandlerRejectsMissingSecretToken(t *testing.T) {
+	h := &WebhookHandler{Bot: NewBot(Settings{Token: "token"}), SecretToken: "expected"}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"update_id":1}`))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != 401 {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+}
+
+func TestWebhookHandlerAcceptsMatchingSecretToken(t *testing.T) {
+	b := NewBot(Settings{Token: "token"})
+
+	var got *Message
+	b.Handle(OnMessage, func(m *Message) { got = m })
+
+	h := &WebhookHandler{Bot: b, SecretToken: "expected"}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"update_id":1,"message":{"message_id":1,"text":"hi"}}`))
+	req.Header.Set(SecretTokenHeader, "expected")
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if got == nil || got.Text != "hi" {
+		t.Fatalf("expected the update to reach the OnMessage handler, got %v", got)
+	}
+}